@@ -0,0 +1,53 @@
+// Command example runs ExampleService.ProcessData against structured
+// key=value input supplied on the command line.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/services/example"
+)
+
+// dataFlags collects repeated --data flag occurrences.
+type dataFlags []string
+
+func (d *dataFlags) String() string {
+	return fmt.Sprint([]string(*d))
+}
+
+func (d *dataFlags) Set(value string) error {
+	*d = append(*d, value)
+	return nil
+}
+
+func main() {
+	var data dataFlags
+	flag.Var(&data, "data", "key=value pair (repeatable); @file.json or @- loads a JSON object")
+	dataFile := flag.String("data-file", "", "path to a JSON object to merge into the input")
+	flag.Parse()
+
+	tokens := []string(data)
+	if *dataFile != "" {
+		tokens = append(tokens, "@"+*dataFile)
+	}
+
+	input, err := example.ParseData(tokens, os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "example:", err)
+		os.Exit(1)
+	}
+
+	result, err := example.NewExampleService().ProcessData(input)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "example:", err)
+		os.Exit(1)
+	}
+
+	if err := json.NewEncoder(os.Stdout).Encode(map[string]string{"result": result}); err != nil {
+		fmt.Fprintln(os.Stderr, "example:", err)
+		os.Exit(1)
+	}
+}
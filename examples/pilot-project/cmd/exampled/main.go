@@ -0,0 +1,55 @@
+// Command exampled runs ExampleService behind an HTTP server so it can be
+// driven by an AI agent (or anything else) across a process boundary.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/services/example"
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/services/example/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	timeout := flag.Duration("timeout", server.DefaultTimeout, "per-request context deadline")
+	flag.Parse()
+
+	svc := example.NewExampleService()
+	srv := server.New(svc, server.WithTimeout(*timeout))
+
+	httpServer := &http.Server{
+		Addr:    *addr,
+		Handler: srv,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("exampled: listening on %s", *addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case err := <-errCh:
+		log.Fatalf("exampled: %v", err)
+	case <-sigCh:
+		log.Print("exampled: shutting down")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Fatalf("exampled: shutdown: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "service.requestID"
+
+// PanicError is the error a Handler returns when Recover catches a panic.
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Logging returns a Middleware that logs each invocation's request ID and
+// outcome via logger; the method name is not logged. It reads the request ID injected by RequestID, so for
+// the log line to include one, register RequestID before Logging in the
+// Use(...) call (Use's first argument is the outermost wrapper, and an
+// outer middleware's context changes are visible to the middleware it
+// wraps) — e.g. reg.Use(RequestID(), Logging(logger)).
+func Logging(logger *log.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) (Response, error) {
+			resp, err := next(ctx, req)
+			if err != nil {
+				logger.Printf("service: request_id=%v error=%v", ctx.Value(requestIDKey), err)
+			} else {
+				logger.Printf("service: request_id=%v ok", ctx.Value(requestIDKey))
+			}
+			return resp, err
+		}
+	}
+}
+
+// RequestID returns a Middleware that injects a unique, monotonically
+// increasing request ID into the context, retrievable with
+// RequestIDFromContext. Register it before any middleware that wants to see
+// the ID (such as Logging) — e.g. reg.Use(RequestID(), Logging(logger)) —
+// since a middleware only sees context values set by the ones that wrap it.
+func RequestID() Middleware {
+	var counter uint64
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) (Response, error) {
+			id := atomic.AddUint64(&counter, 1)
+			ctx = context.WithValue(ctx, requestIDKey, id)
+			return next(ctx, req)
+		}
+	}
+}
+
+// RequestIDFromContext returns the request ID injected by RequestID, if any.
+func RequestIDFromContext(ctx context.Context) (uint64, bool) {
+	id, ok := ctx.Value(requestIDKey).(uint64)
+	return id, ok
+}
+
+// Recover returns a Middleware that converts a panic in the wrapped Handler
+// into a *PanicError instead of crashing the caller.
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) (resp Response, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &PanicError{Value: r}
+				}
+			}()
+			return next(ctx, req)
+		}
+	}
+}
+
+// recoveredPanic carries a panic value recovered on a spawned goroutine back
+// to the goroutine that's waiting on it, so it can be re-raised there
+// instead of crashing the process.
+type recoveredPanic struct {
+	value any
+}
+
+func (p recoveredPanic) Error() string {
+	return fmt.Sprintf("panic: %v", p.value)
+}
+
+// Timeout returns a Middleware that cancels the handler's context after d
+// and returns ctx.Err() if the handler hasn't completed by then.
+func Timeout(d time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req Request) (Response, error) {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+
+			type result struct {
+				resp Response
+				err  error
+			}
+			done := make(chan result, 1)
+			go func() {
+				defer func() {
+					if r := recover(); r != nil {
+						done <- result{err: recoveredPanic{value: r}}
+					}
+				}()
+				resp, err := next(ctx, req)
+				done <- result{resp, err}
+			}()
+
+			select {
+			case <-ctx.Done():
+				return Response{}, ctx.Err()
+			case r := <-done:
+				if p, ok := r.err.(recoveredPanic); ok {
+					panic(p.value)
+				}
+				return r.resp, r.err
+			}
+		}
+	}
+}
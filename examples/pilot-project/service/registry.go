@@ -0,0 +1,142 @@
+// Package service provides a generic, pluggable abstraction for exposing
+// request/response handlers under a name, wiring them through a middleware
+// chain, and driving them from CLI, HTTP, or in-process callers alike.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Request is the generic input to a Handler. Body carries free-form input;
+// Data carries structured key=value input for handlers that want it.
+type Request struct {
+	Body string            `json:"body,omitempty"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// Response is the generic output of a Handler.
+type Response struct {
+	Result string `json:"result,omitempty"`
+}
+
+// Handler processes a Request into a Response.
+type Handler func(ctx context.Context, req Request) (Response, error)
+
+// Middleware wraps a Handler to add cross-cutting behavior such as logging
+// or timeout enforcement.
+type Middleware func(Handler) Handler
+
+// ConflictError is returned by Register when a method name is already
+// registered.
+type ConflictError struct {
+	Method string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("method %q is already registered", e.Method)
+}
+
+// Registry maps method names to handlers and applies a shared middleware
+// chain to every invocation.
+type Registry struct {
+	mu         sync.RWMutex
+	handlers   map[string]Handler
+	middleware []Middleware
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register adds a handler under name. It returns a *ConflictError if name is
+// already registered.
+func (r *Registry) Register(name string, h Handler) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.handlers[name]; exists {
+		return &ConflictError{Method: name}
+	}
+	r.handlers[name] = h
+	return nil
+}
+
+// Use appends middleware to the chain applied to every Invoke call, in the
+// order given: the first Middleware passed is the outermost wrapper.
+func (r *Registry) Use(mw ...Middleware) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.middleware = append(r.middleware, mw...)
+}
+
+// Invoke looks up the handler registered under method, wraps it with the
+// registry's middleware chain, and calls it with req.
+func (r *Registry) Invoke(ctx context.Context, method string, req Request) (Response, error) {
+	r.mu.RLock()
+	h, ok := r.handlers[method]
+	chain := r.middleware
+	r.mu.RUnlock()
+
+	if !ok {
+		return Response{}, fmt.Errorf("unknown method %q", method)
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		h = chain[i](h)
+	}
+	return h(ctx, req)
+}
+
+// httpEnvelope is the JSON shape ServeHTTP writes on the way out: Result on
+// success, Error on failure.
+type httpEnvelope struct {
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ServeHTTP dispatches requests of the form POST /<method> to the matching
+// handler, decoding a JSON request body and encoding the response (or an
+// error message) as JSON. A panic recovered by Recover maps to 500, a
+// deadline exceeded by Timeout maps to 504, and any other handler error
+// maps to 400.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	method := strings.Trim(req.URL.Path, "/")
+	w.Header().Set("Content-Type", "application/json")
+
+	var body Request
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeHTTPError(w, http.StatusBadRequest, fmt.Errorf("decoding request: %w", err))
+		return
+	}
+
+	resp, err := r.Invoke(req.Context(), method, body)
+	if err != nil {
+		status := http.StatusBadRequest
+		var panicErr *PanicError
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			status = http.StatusGatewayTimeout
+		case errors.As(err, &panicErr):
+			status = http.StatusInternalServerError
+		}
+		writeHTTPError(w, status, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(httpEnvelope{Result: resp.Result})
+}
+
+func writeHTTPError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(httpEnvelope{Error: err.Error()})
+}
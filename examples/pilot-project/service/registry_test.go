@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+)
+
+func echoHandler(ctx context.Context, req Request) (Response, error) {
+	return Response{Result: req.Body}, nil
+}
+
+func TestRegistry_RegisterConflict(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.Register("greet", echoHandler); err != nil {
+		t.Fatalf("first Register() unexpected error: %v", err)
+	}
+
+	err := reg.Register("greet", echoHandler)
+	if err == nil {
+		t.Fatal("second Register() expected error, got none")
+	}
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected *ConflictError, got %T: %v", err, err)
+	}
+}
+
+func TestRegistry_Invoke_UnknownMethod(t *testing.T) {
+	reg := NewRegistry()
+
+	_, err := reg.Invoke(context.Background(), "missing", Request{})
+	if err == nil {
+		t.Error("Invoke() for unregistered method expected error, got none")
+	}
+}
+
+func TestRegistry_MiddlewareOrdering(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req Request) (Response, error) {
+				order = append(order, name+":before")
+				resp, err := next(ctx, req)
+				order = append(order, name+":after")
+				return resp, err
+			}
+		}
+	}
+
+	reg := NewRegistry()
+	if err := reg.Register("echo", echoHandler); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(record("outer"), record("inner"))
+
+	if _, err := reg.Invoke(context.Background(), "echo", Request{Body: "hi"}); err != nil {
+		t.Fatalf("Invoke() unexpected error: %v", err)
+	}
+
+	want := []string{"outer:before", "inner:before", "inner:after", "outer:after"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
+
+func TestRecover_ConvertsPanicToError(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("boom", func(ctx context.Context, req Request) (Response, error) {
+		panic("kaboom")
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(Recover())
+
+	_, err := reg.Invoke(context.Background(), "boom", Request{})
+	if err == nil {
+		t.Fatal("Invoke() expected error from recovered panic, got none")
+	}
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Errorf("expected *PanicError, got %T: %v", err, err)
+	}
+}
+
+func TestRecover_CatchesPanicAcrossTimeout(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("boom", func(ctx context.Context, req Request) (Response, error) {
+		panic("kaboom")
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(Recover(), Timeout(time.Second))
+
+	_, err := reg.Invoke(context.Background(), "boom", Request{})
+	if err == nil {
+		t.Fatal("Invoke() expected error from recovered panic, got none")
+	}
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Errorf("expected *PanicError, got %T: %v", err, err)
+	}
+}
+
+func TestTimeout_PropagatesCancellation(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("slow", func(ctx context.Context, req Request) (Response, error) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return Response{Result: "too late"}, nil
+		case <-ctx.Done():
+			return Response{}, ctx.Err()
+		}
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(Timeout(time.Millisecond))
+
+	_, err := reg.Invoke(context.Background(), "slow", Request{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Invoke() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRequestID_Injected(t *testing.T) {
+	reg := NewRegistry()
+	var gotID uint64
+	var ok bool
+	if err := reg.Register("whoami", func(ctx context.Context, req Request) (Response, error) {
+		gotID, ok = RequestIDFromContext(ctx)
+		return Response{}, nil
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(RequestID())
+
+	if _, err := reg.Invoke(context.Background(), "whoami", Request{}); err != nil {
+		t.Fatalf("Invoke() unexpected error: %v", err)
+	}
+	if !ok || gotID == 0 {
+		t.Errorf("expected a non-zero injected request ID, got %d (ok=%v)", gotID, ok)
+	}
+}
+
+func TestLogging_SeesRequestID_WhenRequestIDRegisteredFirst(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.New(&buf, "", 0)
+
+	reg := NewRegistry()
+	if err := reg.Register("echo", echoHandler); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	reg.Use(RequestID(), Logging(logger))
+
+	if _, err := reg.Invoke(context.Background(), "echo", Request{Body: "hi"}); err != nil {
+		t.Fatalf("Invoke() unexpected error: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "request_id=<nil>") {
+		t.Errorf("log line missing request ID: %q", buf.String())
+	}
+}
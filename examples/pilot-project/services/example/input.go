@@ -0,0 +1,137 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// InputError reports a problem with a single `--data` token, identifying
+// which token failed and why.
+type InputError struct {
+	Token    string
+	Position int
+	Reason   string
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("invalid data token %d (%q): %s", e.Position, e.Token, e.Reason)
+}
+
+type parseConfig struct {
+	override bool
+}
+
+// ParseOption configures ParseData.
+type ParseOption func(*parseConfig)
+
+// WithOverride allows later tokens to replace the value of a key set by an
+// earlier token. Without it, a duplicate key is a parse error.
+func WithOverride() ParseOption {
+	return func(c *parseConfig) {
+		c.override = true
+	}
+}
+
+// ParseData parses repeated `key=value` tokens into a map, following the
+// `--data key=value` convention used by sibling agent-execution tools. A
+// token of the form `@file.json` or `@-` loads a JSON object from the named
+// file (or stdin, for `@-`) and merges its entries into the result.
+//
+// Keys must match [A-Za-z_][A-Za-z0-9_]*. Values may be wrapped in matching
+// double quotes, which are stripped; everything after the first `=` is taken
+// verbatim, so values may themselves contain `=`. Duplicate keys are a parse
+// error unless WithOverride is passed.
+func ParseData(tokens []string, stdin io.Reader, opts ...ParseOption) (map[string]string, error) {
+	cfg := parseConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	result := make(map[string]string)
+	for i, token := range tokens {
+		if rest, ok := strings.CutPrefix(token, "@"); ok {
+			entries, err := loadDataFile(rest, stdin)
+			if err != nil {
+				return nil, &InputError{Token: token, Position: i, Reason: err.Error()}
+			}
+			for k, v := range entries {
+				if !keyPattern.MatchString(k) {
+					return nil, &InputError{Token: token, Position: i, Reason: fmt.Sprintf("key %q must match [A-Za-z_][A-Za-z0-9_]*", k)}
+				}
+				if err := setKey(result, k, v, cfg.override); err != nil {
+					return nil, &InputError{Token: token, Position: i, Reason: err.Error()}
+				}
+			}
+			continue
+		}
+
+		idx := strings.IndexByte(token, '=')
+		if idx == -1 {
+			return nil, &InputError{Token: token, Position: i, Reason: "missing '='"}
+		}
+
+		key := token[:idx]
+		if key == "" {
+			return nil, &InputError{Token: token, Position: i, Reason: "empty key"}
+		}
+		if !keyPattern.MatchString(key) {
+			return nil, &InputError{Token: token, Position: i, Reason: "key must match [A-Za-z_][A-Za-z0-9_]*"}
+		}
+
+		value := unquote(token[idx+1:])
+		if err := setKey(result, key, value, cfg.override); err != nil {
+			return nil, &InputError{Token: token, Position: i, Reason: err.Error()}
+		}
+	}
+
+	return result, nil
+}
+
+func setKey(dst map[string]string, key, value string, override bool) error {
+	if _, exists := dst[key]; exists && !override {
+		return fmt.Errorf("duplicate key %q (pass WithOverride to allow)", key)
+	}
+	dst[key] = value
+	return nil
+}
+
+func loadDataFile(path string, stdin io.Reader) (map[string]string, error) {
+	var r io.Reader
+	if path == "-" {
+		if stdin == nil {
+			stdin = os.Stdin
+		}
+		r = stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var entries map[string]string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("decoding %s as a JSON object of strings: %w", path, err)
+	}
+	return entries, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
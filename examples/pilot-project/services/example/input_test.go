@@ -0,0 +1,131 @@
+package example
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseData(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokens  []string
+		opts    []ParseOption
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "simple pairs",
+			tokens: []string{"foo=bar", "baz=qux"},
+			want:   map[string]string{"foo": "bar", "baz": "qux"},
+		},
+		{
+			name:   "quoted value",
+			tokens: []string{`name="Ada Lovelace"`},
+			want:   map[string]string{"name": "Ada Lovelace"},
+		},
+		{
+			name:   "equals inside value",
+			tokens: []string{"expr=1+1=2"},
+			want:   map[string]string{"expr": "1+1=2"},
+		},
+		{
+			name:    "empty key",
+			tokens:  []string{"=value"},
+			wantErr: true,
+		},
+		{
+			name:    "missing equals",
+			tokens:  []string{"novalue"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid key characters",
+			tokens:  []string{"1bad=value"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate key without override",
+			tokens:  []string{"foo=bar", "foo=baz"},
+			wantErr: true,
+		},
+		{
+			name:   "duplicate key with override",
+			tokens: []string{"foo=bar", "foo=baz"},
+			opts:   []ParseOption{WithOverride()},
+			want:   map[string]string{"foo": "baz"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseData(tt.tokens, nil, tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseData(%v) expected error, got none", tt.tokens)
+				}
+				var inputErr *InputError
+				if !errors.As(err, &inputErr) {
+					t.Errorf("expected *InputError, got %T: %v", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseData(%v) unexpected error: %v", tt.tokens, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseData(%v) = %v, want %v", tt.tokens, got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("ParseData(%v)[%q] = %q, want %q", tt.tokens, k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestParseData_StdinEscape(t *testing.T) {
+	stdin := strings.NewReader(`{"foo":"bar"}`)
+	got, err := ParseData([]string{"@-"}, stdin)
+	if err != nil {
+		t.Fatalf("ParseData(@-) unexpected error: %v", err)
+	}
+	if got["foo"] != "bar" {
+		t.Errorf("ParseData(@-)[foo] = %q, want %q", got["foo"], "bar")
+	}
+}
+
+func TestParseData_StdinEscapeRejectsInvalidKeys(t *testing.T) {
+	stdin := strings.NewReader(`{"1bad":"x","has space":"y"}`)
+
+	_, err := ParseData([]string{"@-"}, stdin)
+	if err == nil {
+		t.Fatal("ParseData(@-) expected error for invalid keys, got none")
+	}
+	var inputErr *InputError
+	if !errors.As(err, &inputErr) {
+		t.Errorf("expected *InputError, got %T: %v", err, err)
+	}
+}
+
+func TestExampleService_ProcessData(t *testing.T) {
+	service := NewExampleService()
+
+	t.Run("valid input", func(t *testing.T) {
+		result, err := service.ProcessData(map[string]string{"b": "2", "a": "1"})
+		if err != nil {
+			t.Fatalf("ProcessData() unexpected error: %v", err)
+		}
+		if result != "Processed: a=1, b=2" {
+			t.Errorf("ProcessData() = %q, want %q", result, "Processed: a=1, b=2")
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		_, err := service.ProcessData(map[string]string{})
+		if err == nil {
+			t.Error("ProcessData() expected error for empty input")
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package example
+
+import (
+	"context"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/service"
+)
+
+// NewRegistry registers svc's methods as service.Handlers under "greet" and
+// "process", so the same service.Registry machinery (middleware, HTTP
+// dispatch, in-process Invoke) can drive ExampleService. The ExampleService
+// methods themselves remain the stable, backward-compatible API for callers
+// that just want to call Greet/Process directly.
+func NewRegistry(svc *ExampleService) *service.Registry {
+	reg := service.NewRegistry()
+
+	// Registration only fails on a duplicate name, which cannot happen here;
+	// these errors are therefore safe to discard.
+	_ = reg.Register("greet", func(ctx context.Context, req service.Request) (service.Response, error) {
+		return service.Response{Result: svc.Greet(req.Body)}, nil
+	})
+
+	_ = reg.Register("process", func(ctx context.Context, req service.Request) (service.Response, error) {
+		var (
+			result string
+			err    error
+		)
+		if len(req.Data) > 0 {
+			result, err = svc.ProcessData(req.Data)
+		} else {
+			result, err = svc.Process(req.Body)
+		}
+		if err != nil {
+			return service.Response{}, err
+		}
+		return service.Response{Result: result}, nil
+	})
+
+	return reg
+}
@@ -0,0 +1,51 @@
+package example
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/service"
+)
+
+func TestNewRegistry_Greet(t *testing.T) {
+	reg := NewRegistry(NewExampleService())
+
+	resp, err := reg.Invoke(context.Background(), "greet", service.Request{Body: "World"})
+	if err != nil {
+		t.Fatalf("Invoke(greet) unexpected error: %v", err)
+	}
+	if resp.Result != "Hello, World!" {
+		t.Errorf("Invoke(greet) = %q, want %q", resp.Result, "Hello, World!")
+	}
+}
+
+func TestNewRegistry_Process(t *testing.T) {
+	reg := NewRegistry(NewExampleService())
+
+	t.Run("free-form body", func(t *testing.T) {
+		resp, err := reg.Invoke(context.Background(), "process", service.Request{Body: "test data"})
+		if err != nil {
+			t.Fatalf("Invoke(process) unexpected error: %v", err)
+		}
+		if resp.Result != "Processed: test data" {
+			t.Errorf("Invoke(process) = %q, want %q", resp.Result, "Processed: test data")
+		}
+	})
+
+	t.Run("structured data", func(t *testing.T) {
+		resp, err := reg.Invoke(context.Background(), "process", service.Request{Data: map[string]string{"a": "1"}})
+		if err != nil {
+			t.Fatalf("Invoke(process) unexpected error: %v", err)
+		}
+		if resp.Result != "Processed: a=1" {
+			t.Errorf("Invoke(process) = %q, want %q", resp.Result, "Processed: a=1")
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		_, err := reg.Invoke(context.Background(), "process", service.Request{})
+		if err == nil {
+			t.Error("Invoke(process) expected error for empty input")
+		}
+	})
+}
@@ -0,0 +1,63 @@
+// Package server exposes ExampleService over HTTP by layering CORS support
+// on top of a service.Registry, so the service can be driven across a
+// process boundary instead of only in-process.
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/service"
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/services/example"
+)
+
+// DefaultTimeout bounds how long a single request is allowed to run before
+// its context is cancelled.
+const DefaultTimeout = 2 * time.Second
+
+// Server wires ExampleService's registry up to HTTP, adding CORS support and
+// installing Recover and a per-request Timeout middleware on the registry.
+type Server struct {
+	registry *service.Registry
+}
+
+// Option configures a Server.
+type Option func(*serverConfig)
+
+type serverConfig struct {
+	timeout time.Duration
+}
+
+// WithTimeout overrides the default per-request context deadline.
+func WithTimeout(d time.Duration) Option {
+	return func(c *serverConfig) {
+		c.timeout = d
+	}
+}
+
+// New creates a Server backed by svc's registry.
+func New(svc *example.ExampleService, opts ...Option) *Server {
+	cfg := serverConfig{timeout: DefaultTimeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	reg := example.NewRegistry(svc)
+	reg.Use(service.Recover(), service.Timeout(cfg.timeout))
+
+	return &Server{registry: reg}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.registry.ServeHTTP(w, r)
+}
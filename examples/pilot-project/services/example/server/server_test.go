@@ -0,0 +1,145 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/service"
+	"github.com/jovermier/cc-coder-platform/examples/pilot-project/services/example"
+)
+
+type httpEnvelope struct {
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func newTestServer(opts ...Option) *Server {
+	return New(example.NewExampleService(), opts...)
+}
+
+func doRequest(t *testing.T, srv *Server, path, body string) (*http.Response, httpEnvelope) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	var resp httpEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return rec.Result(), resp
+}
+
+func TestServer_Greet(t *testing.T) {
+	srv := newTestServer()
+
+	res, resp := doRequest(t, srv, "/greet", `{"body":"World"}`)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if resp.Result != "Hello, World!" {
+		t.Errorf("result = %q, want %q", resp.Result, "Hello, World!")
+	}
+}
+
+func TestServer_Process(t *testing.T) {
+	srv := newTestServer()
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			body:       `{"body":"test data"}`,
+			wantStatus: http.StatusOK,
+			wantResult: "Processed: test data",
+		},
+		{
+			name:       "empty input maps to 400",
+			body:       `{"body":""}`,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, resp := doRequest(t, srv, "/process", tt.body)
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+			if tt.wantErr && resp.Error == "" {
+				t.Error("expected error in response, got none")
+			}
+			if !tt.wantErr && resp.Result != tt.wantResult {
+				t.Errorf("result = %q, want %q", resp.Result, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestServer_CORS(t *testing.T) {
+	srv := newTestServer()
+
+	res, _ := doRequest(t, srv, "/greet", `{"body":"World"}`)
+	if got := res.Header.Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, "*")
+	}
+}
+
+func TestServer_OPTIONS(t *testing.T) {
+	srv := newTestServer()
+
+	req := httptest.NewRequest(http.MethodOptions, "/greet", nil)
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+}
+
+func TestServer_Timeout(t *testing.T) {
+	srv := New(example.NewExampleService(), WithTimeout(time.Millisecond))
+	if err := srv.registry.Register("slow", func(ctx context.Context, req service.Request) (service.Response, error) {
+		time.Sleep(20 * time.Millisecond)
+		return service.Response{Result: "too late"}, nil
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+
+	res, resp := doRequest(t, srv, "/slow", `{"body":"x"}`)
+	if res.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusGatewayTimeout)
+	}
+	if resp.Error == "" {
+		t.Error("expected error in response, got none")
+	}
+}
+
+func TestServer_HandlerPanicRecovered(t *testing.T) {
+	srv := newTestServer()
+	if err := srv.registry.Register("boom", func(ctx context.Context, req service.Request) (service.Response, error) {
+		panic("kaboom")
+	}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+
+	res, resp := doRequest(t, srv, "/boom", `{"body":"x"}`)
+	if res.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusInternalServerError)
+	}
+	if resp.Error == "" {
+		t.Error("expected error in response, got none")
+	}
+}
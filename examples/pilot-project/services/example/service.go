@@ -1,6 +1,12 @@
-package main
+// Package example implements a minimal service used to exercise AI agent
+// workflows end-to-end, from in-process calls to CLI and HTTP transports.
+package example
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // ExampleService is a simple service for testing AI agent workflows
 type ExampleService struct {
@@ -26,3 +32,24 @@ func (s *ExampleService) Process(input string) (string, error) {
 	}
 	return fmt.Sprintf("Processed: %s", input), nil
 }
+
+// ProcessData processes structured key=value input, as produced by ParseData.
+// It is the structured counterpart to Process for callers that want to pass
+// more than a single free-form string.
+func (s *ExampleService) ProcessData(data map[string]string) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("input cannot be empty")
+	}
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, data[k]))
+	}
+	return fmt.Sprintf("Processed: %s", strings.Join(pairs, ", ")), nil
+}